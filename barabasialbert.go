@@ -0,0 +1,174 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+)
+
+// BarabasiAlbert implements the [Source] interface. It generates
+// scale-free random graphs using the Barabási–Albert preferential
+// attachment model: starting from a small seed, each new vertex is
+// connected to M existing vertices chosen with probability
+// proportional to their current degree.
+//
+// The target selection uses the Batagelj–Brandes constant-time
+// sampling trick: an append-only slice records both endpoints of
+// every edge as it is created, so picking a vertex with probability
+// proportional to its degree is just picking a uniformly random index
+// into that slice. This keeps the whole generator at O(V·M) time and
+// memory, in line with the package's streaming philosophy, since only
+// the endpoints slice (not the full graph) is ever retained.
+type BarabasiAlbert struct {
+	// V is the number of vertices.
+	V int
+
+	// M is the number of edges a new vertex attaches with.
+	M int
+
+	// M0 is the number of seed vertices the graph starts from. It
+	// must be at least M. If zero, it defaults to M. Regardless of
+	// the configured value, at least 2 seed vertices are used (or V,
+	// if V is smaller), since the endpoint pool that later
+	// attachments sample from must be non-empty even when M is 1.
+	M0 int
+
+	// SeedPath defines whether the seed vertices are connected as a
+	// path (M0-1 -> ... -> 1 -> 0) instead of a clique.
+	SeedPath bool
+
+	// Directed defines whether the generated graphs are directed. The
+	// tail is always the newly added vertex and the head is the
+	// existing vertex it attaches to.
+	Directed bool
+
+	// VertexLabel specifies an optional function that returns the
+	// label of a vertex identified by id.
+	VertexLabel func(id int) any
+
+	// EdgeLabel specifies an optional function that returns the
+	// label of an edge identified by id that connects v0 and v1.
+	EdgeLabel func(id, v0, v1 int) any
+
+	rand *rand.Rand
+}
+
+// NewBarabasiAlbert returns a new [BarabasiAlbert] source that
+// generates graphs with v vertices, where each new vertex attaches to
+// m existing vertices chosen with probability proportional to their
+// degree.
+func NewBarabasiAlbert(v, m int) (*BarabasiAlbert, error) {
+	return NewBarabasiAlbertWithRand(v, m, Seed(rand.Uint64(), rand.Uint64()))
+}
+
+// NewBarabasiAlbertWithRand is like [NewBarabasiAlbert], but it uses
+// r as the source of randomness instead of a randomly seeded one.
+// This allows reproducible runs and sharing a single [rand.Rand]
+// across a pipeline of sources.
+func NewBarabasiAlbertWithRand(v, m int, r *rand.Rand) (*BarabasiAlbert, error) {
+	if v < 0 {
+		return nil, errors.New("invalid number of vertices")
+	}
+	if m < 1 {
+		return nil, errors.New("invalid number of attachments")
+	}
+	if v > 0 && v < m {
+		return nil, errors.New("v must be at least m")
+	}
+
+	ba := &BarabasiAlbert{
+		V:    v,
+		M:    m,
+		rand: r,
+	}
+	return ba, nil
+}
+
+func (ba *BarabasiAlbert) Vertices() <-chan Vertex {
+	ch := make(chan Vertex)
+	go func() {
+		for i := range ba.V {
+			var label any
+			if ba.VertexLabel != nil {
+				label = ba.VertexLabel(i)
+			}
+			ch <- Vertex{ID: i, Label: label}
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+func (ba *BarabasiAlbert) Edges() <-chan Edge {
+	ch := make(chan Edge)
+	go func() {
+		m0 := ba.M0
+		if m0 < ba.M {
+			m0 = ba.M
+		}
+		if m0 < 2 {
+			// A single seed vertex has no edges to sample from, so
+			// the first growth vertex would have an empty endpoint
+			// pool to attach to.
+			m0 = 2
+		}
+		if m0 > ba.V {
+			m0 = ba.V
+		}
+
+		id := 0
+		emit := func(v0, v1 int) {
+			var label any
+			if ba.EdgeLabel != nil {
+				label = ba.EdgeLabel(id, v0, v1)
+			}
+			ch <- Edge{
+				ID:       id,
+				V0:       v0,
+				V1:       v1,
+				Directed: ba.Directed,
+				Label:    label,
+			}
+			id++
+		}
+
+		var endpoints []int
+
+		if ba.SeedPath {
+			for i := 1; i < m0; i++ {
+				emit(i, i-1)
+				endpoints = append(endpoints, i, i-1)
+			}
+		} else {
+			for i := range m0 {
+				for j := i + 1; j < m0; j++ {
+					emit(j, i)
+					endpoints = append(endpoints, j, i)
+				}
+			}
+		}
+
+		targets := make([]int, 0, ba.M)
+		for t := m0; t < ba.V; t++ {
+			targets = targets[:0]
+			for len(targets) < ba.M {
+				target := endpoints[ba.rand.IntN(len(endpoints))]
+				if target == t || slices.Contains(targets, target) {
+					continue
+				}
+				targets = append(targets, target)
+			}
+			for _, target := range targets {
+				emit(t, target)
+				endpoints = append(endpoints, t, target)
+			}
+		}
+
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewBarabasiAlbert(t *testing.T) {
+	tests := []struct {
+		name       string
+		v          int
+		m          int
+		wantNilErr bool
+	}{
+		{
+			name:       "zero",
+			m:          1,
+			wantNilErr: true,
+		},
+		{
+			name:       "v < 0",
+			v:          -1,
+			m:          1,
+			wantNilErr: false,
+		},
+		{
+			name:       "m < 1",
+			v:          5,
+			wantNilErr: false,
+		},
+		{
+			name:       "v < m",
+			v:          2,
+			m:          3,
+			wantNilErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ba, err := NewBarabasiAlbert(tt.v, tt.m)
+			if (err == nil) != tt.wantNilErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if (ba == nil) != !tt.wantNilErr {
+				t.Errorf("unexpected value: %v", ba)
+			}
+		})
+	}
+}
+
+func TestNewBarabasiAlbertWithRand(t *testing.T) {
+	ba, err := NewBarabasiAlbertWithRand(8, 2, testRand())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := NewBarabasiAlbert(8, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want.rand = testRand()
+
+	var gotEs, wantEs []Edge
+	for e := range ba.Edges() {
+		gotEs = append(gotEs, e)
+	}
+	for e := range want.Edges() {
+		wantEs = append(wantEs, e)
+	}
+	if !slices.Equal(gotEs, wantEs) {
+		t.Errorf("unexpected edges: got: %v, want: %v", gotEs, wantEs)
+	}
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        int
+		m        int
+		m0       int
+		seedPath bool
+		directed bool
+		wantVs   []Vertex
+		wantEs   []Edge
+	}{
+		{
+			name: "clique seed",
+			v:    8,
+			m:    2,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}, {ID: 6}, {ID: 7},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 1, V1: 0},
+				{ID: 1, V0: 2, V1: 1},
+				{ID: 2, V0: 2, V1: 0},
+				{ID: 3, V0: 3, V1: 2},
+				{ID: 4, V0: 3, V1: 1},
+				{ID: 5, V0: 4, V1: 0},
+				{ID: 6, V0: 4, V1: 3},
+				{ID: 7, V0: 5, V1: 3},
+				{ID: 8, V0: 5, V1: 0},
+				{ID: 9, V0: 6, V1: 2},
+				{ID: 10, V0: 6, V1: 5},
+				{ID: 11, V0: 7, V1: 5},
+				{ID: 12, V0: 7, V1: 0},
+			},
+		},
+		{
+			name:     "path seed",
+			v:        8,
+			m:        2,
+			seedPath: true,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}, {ID: 6}, {ID: 7},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 1, V1: 0},
+				{ID: 1, V0: 2, V1: 1},
+				{ID: 2, V0: 2, V1: 0},
+				{ID: 3, V0: 3, V1: 2},
+				{ID: 4, V0: 3, V1: 1},
+				{ID: 5, V0: 4, V1: 0},
+				{ID: 6, V0: 4, V1: 3},
+				{ID: 7, V0: 5, V1: 3},
+				{ID: 8, V0: 5, V1: 0},
+				{ID: 9, V0: 6, V1: 2},
+				{ID: 10, V0: 6, V1: 5},
+				{ID: 11, V0: 7, V1: 5},
+				{ID: 12, V0: 7, V1: 0},
+			},
+		},
+		{
+			name:     "directed",
+			v:        8,
+			m:        2,
+			directed: true,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}, {ID: 6}, {ID: 7},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 1, V1: 0, Directed: true},
+				{ID: 1, V0: 2, V1: 1, Directed: true},
+				{ID: 2, V0: 2, V1: 0, Directed: true},
+				{ID: 3, V0: 3, V1: 2, Directed: true},
+				{ID: 4, V0: 3, V1: 1, Directed: true},
+				{ID: 5, V0: 4, V1: 0, Directed: true},
+				{ID: 6, V0: 4, V1: 3, Directed: true},
+				{ID: 7, V0: 5, V1: 3, Directed: true},
+				{ID: 8, V0: 5, V1: 0, Directed: true},
+				{ID: 9, V0: 6, V1: 2, Directed: true},
+				{ID: 10, V0: 6, V1: 5, Directed: true},
+				{ID: 11, V0: 7, V1: 5, Directed: true},
+				{ID: 12, V0: 7, V1: 0, Directed: true},
+			},
+		},
+		{
+			name: "explicit m0",
+			v:    5,
+			m:    3,
+			m0:   4,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 1, V1: 0},
+				{ID: 1, V0: 2, V1: 0},
+				{ID: 2, V0: 3, V1: 0},
+				{ID: 3, V0: 2, V1: 1},
+				{ID: 4, V0: 3, V1: 1},
+				{ID: 5, V0: 3, V1: 2},
+				{ID: 6, V0: 4, V1: 1},
+				{ID: 7, V0: 4, V1: 2},
+				{ID: 8, V0: 4, V1: 0},
+			},
+		},
+		{
+			name: "m=1 bootstraps a 2-vertex seed",
+			v:    6,
+			m:    1,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 1, V1: 0},
+				{ID: 1, V0: 2, V1: 1},
+				{ID: 2, V0: 3, V1: 1},
+				{ID: 3, V0: 4, V1: 3},
+				{ID: 4, V0: 5, V1: 2},
+			},
+		},
+		{
+			name: "seed only",
+			v:    1,
+			m:    1,
+			wantVs: []Vertex{
+				{ID: 0},
+			},
+			wantEs: []Edge{},
+		},
+		{
+			name:   "order zero",
+			v:      0,
+			m:      1,
+			wantVs: []Vertex{},
+			wantEs: []Edge{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ba, err := NewBarabasiAlbert(tt.v, tt.m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ba.M0 = tt.m0
+			ba.SeedPath = tt.seedPath
+			ba.Directed = tt.directed
+			ba.rand = testRand()
+
+			var gotVs []Vertex
+			for v := range ba.Vertices() {
+				gotVs = append(gotVs, v)
+			}
+			if !slices.Equal(gotVs, tt.wantVs) {
+				t.Errorf("unexpected vertices: got: %v, want: %v", gotVs, tt.wantVs)
+			}
+
+			var gotEs []Edge
+			for e := range ba.Edges() {
+				gotEs = append(gotEs, e)
+			}
+			if !slices.Equal(gotEs, tt.wantEs) {
+				t.Errorf("unexpected edges: got: %v, want: %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
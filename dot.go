@@ -0,0 +1,158 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+var (
+	dotNodeRe = regexp.MustCompile(`^\s*(\d+) \[label="((?:[^"\\]|\\.)*)"\]\s*$`)
+	dotEdgeRe = regexp.MustCompile(`^\s*(\d+) -> (\d+) \[dir="(forward|none)"\] \[label="((?:[^"\\]|\\.)*)"\]\s*$`)
+)
+
+// A DOTSource is a [Source] that replays a graph parsed from [DOT]
+// text by [ReadDOT].
+//
+// [DOT]: https://graphviz.org/doc/info/lang.html
+type DOTSource struct {
+	vertices []Vertex
+	edges    []Edge
+}
+
+// ReadDOT parses the subset of [DOT] produced by [RandGraph.WriteDOT]
+// — a "digraph { ... }" block containing node lines of the form
+// `ID [label="..."]` and edge lines of the form `A -> B
+// [dir="forward|none"] [label="..."]` — and returns the result as a
+// [Source]. This allows a generated graph to be piped through
+// external DOT tooling (e.g. Graphviz layouts, gvpr filters) and
+// re-ingested, or a captured stream to be replayed deterministically
+// in tests.
+//
+// Original vertex IDs are preserved, but edge IDs are renumbered
+// sequentially in the order edges are read, since WriteDOT does not
+// emit them. Labels round-trip as strings, including any character
+// WriteDOT's %q formatting escapes; an empty label is read back as a
+// nil Label, matching how WriteDOT renders a nil Label.
+//
+// ReadDOT returns an error identifying the offending line number if r
+// does not contain valid input.
+//
+// [DOT]: https://graphviz.org/doc/info/lang.html
+func ReadDOT(r io.Reader) (Source, error) {
+	sc := bufio.NewScanner(r)
+
+	line := 0
+	nextLine := func() (string, bool) {
+		if !sc.Scan() {
+			return "", false
+		}
+		line++
+		return sc.Text(), true
+	}
+
+	header, ok := nextLine()
+	if !ok {
+		return nil, fmt.Errorf("randgraph: dot:%d: missing header", line+1)
+	}
+	if header != "digraph {" {
+		return nil, fmt.Errorf("randgraph: dot:%d: malformed header: %q", line, header)
+	}
+
+	var src DOTSource
+	for {
+		l, ok := nextLine()
+		if !ok {
+			return nil, fmt.Errorf("randgraph: dot:%d: missing closing brace", line+1)
+		}
+		if l == "}" {
+			break
+		}
+
+		if m := dotNodeRe.FindStringSubmatch(l); m != nil {
+			id, err := strconv.Atoi(m[1])
+			if err != nil {
+				return nil, fmt.Errorf("randgraph: dot:%d: invalid vertex id: %w", line, err)
+			}
+			label, err := unquoteLabel(m[2])
+			if err != nil {
+				return nil, fmt.Errorf("randgraph: dot:%d: invalid label: %w", line, err)
+			}
+			src.vertices = append(src.vertices, Vertex{ID: id, Label: label})
+			continue
+		}
+
+		if m := dotEdgeRe.FindStringSubmatch(l); m != nil {
+			v0, err := strconv.Atoi(m[1])
+			if err != nil {
+				return nil, fmt.Errorf("randgraph: dot:%d: invalid vertex id: %w", line, err)
+			}
+			v1, err := strconv.Atoi(m[2])
+			if err != nil {
+				return nil, fmt.Errorf("randgraph: dot:%d: invalid vertex id: %w", line, err)
+			}
+			label, err := unquoteLabel(m[4])
+			if err != nil {
+				return nil, fmt.Errorf("randgraph: dot:%d: invalid label: %w", line, err)
+			}
+			src.edges = append(src.edges, Edge{
+				ID:       len(src.edges),
+				V0:       v0,
+				V1:       v1,
+				Directed: m[3] == "forward",
+				Label:    label,
+			})
+			continue
+		}
+
+		return nil, fmt.Errorf("randgraph: dot:%d: malformed line: %q", line, l)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("randgraph: dot: %w", err)
+	}
+	return &src, nil
+}
+
+// unquoteLabel decodes s, the Go-escaped contents of a WriteDOT
+// label (without its surrounding quotes), reversing the %q
+// formatting WriteDOT applies. An empty label decodes to a nil Label,
+// matching how WriteDOT renders a nil Label.
+func unquoteLabel(s string) (any, error) {
+	if s == "" {
+		return nil, nil
+	}
+	label, err := strconv.Unquote(`"` + s + `"`)
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+func (src *DOTSource) Vertices() <-chan Vertex {
+	ch := make(chan Vertex)
+	go func() {
+		for _, v := range src.vertices {
+			ch <- v
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+func (src *DOTSource) Edges() <-chan Edge {
+	ch := make(chan Edge)
+	go func() {
+		for _, e := range src.edges {
+			ch <- e
+		}
+		close(ch)
+	}()
+	return ch
+}
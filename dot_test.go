@@ -0,0 +1,122 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReadDOT_RoundTrip(t *testing.T) {
+	vertices := []Vertex{
+		{ID: 0, Label: "v0"},
+		{ID: 1},
+		{ID: 2, Label: "v2"},
+	}
+	edges := []Edge{
+		{ID: 0, V0: 0, V1: 1, Label: "e0"},
+		{ID: 1, V0: 1, V1: 2, Directed: true},
+		{ID: 2, V0: 2, V1: 0, Directed: true, Label: "e2"},
+	}
+
+	r := New(newTestSource(vertices, edges))
+	buf := &bytes.Buffer{}
+	r.WriteDOT(buf)
+
+	src, err := ReadDOT(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotVs []Vertex
+	for v := range src.Vertices() {
+		gotVs = append(gotVs, v)
+	}
+	if !slices.Equal(gotVs, vertices) {
+		t.Errorf("unexpected vertices: got: %v, want: %v", gotVs, vertices)
+	}
+
+	var gotEs []Edge
+	for e := range src.Edges() {
+		gotEs = append(gotEs, e)
+	}
+	if !slices.Equal(gotEs, edges) {
+		t.Errorf("unexpected edges: got: %v, want: %v", gotEs, edges)
+	}
+}
+
+func TestReadDOT_RoundTripEscapedLabels(t *testing.T) {
+	vertices := []Vertex{
+		{ID: 0, Label: `he said "hi"`},
+		{ID: 1, Label: "line1\nline2"},
+	}
+	edges := []Edge{
+		{ID: 0, V0: 0, V1: 1, Label: `back\slash`},
+	}
+
+	r := New(newTestSource(vertices, edges))
+	buf := &bytes.Buffer{}
+	r.WriteDOT(buf)
+
+	src, err := ReadDOT(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotVs []Vertex
+	for v := range src.Vertices() {
+		gotVs = append(gotVs, v)
+	}
+	if !slices.Equal(gotVs, vertices) {
+		t.Errorf("unexpected vertices: got: %v, want: %v", gotVs, vertices)
+	}
+
+	var gotEs []Edge
+	for e := range src.Edges() {
+		gotEs = append(gotEs, e)
+	}
+	if !slices.Equal(gotEs, edges) {
+		t.Errorf("unexpected edges: got: %v, want: %v", gotEs, edges)
+	}
+}
+
+func TestReadDOT_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{
+			name: "missing header",
+			in:   "",
+		},
+		{
+			name: "malformed header",
+			in:   "not dot\n}\n",
+		},
+		{
+			name: "malformed line",
+			in:   "digraph {\n  bogus line\n}\n",
+		},
+		{
+			name: "missing closing brace",
+			in:   "digraph {\n  0 [label=\"\"]\n",
+		},
+		{
+			name: "invalid escape sequence in label",
+			in:   "digraph {\n  0 [label=\"\\q\"]\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadDOT(strings.NewReader(tt.in))
+			if err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewGnp(t *testing.T) {
+	tests := []struct {
+		name       string
+		v          int
+		p          float64
+		wantNilErr bool
+	}{
+		{
+			name:       "zero",
+			wantNilErr: true,
+		},
+		{
+			name:       "v < 0",
+			v:          -1,
+			wantNilErr: false,
+		},
+		{
+			name:       "p < 0",
+			p:          -0.1,
+			wantNilErr: false,
+		},
+		{
+			name:       "p > 1",
+			p:          1.1,
+			wantNilErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewGnp(tt.v, tt.p)
+			if (err == nil) != tt.wantNilErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if (g == nil) != !tt.wantNilErr {
+				t.Errorf("unexpected value: %v", g)
+			}
+		})
+	}
+}
+
+func TestNewGnpWithRand(t *testing.T) {
+	g, err := NewGnpWithRand(6, 0.5, testRand())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := NewGnp(6, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want.rand = testRand()
+
+	var gotEs, wantEs []Edge
+	for e := range g.Edges() {
+		gotEs = append(gotEs, e)
+	}
+	for e := range want.Edges() {
+		wantEs = append(wantEs, e)
+	}
+	if !slices.Equal(gotEs, wantEs) {
+		t.Errorf("unexpected edges: got: %v, want: %v", gotEs, wantEs)
+	}
+}
+
+func TestGnp(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        int
+		p        float64
+		loops    bool
+		directed bool
+		wantVs   []Vertex
+		wantEs   []Edge
+	}{
+		{
+			name: "undirected",
+			v:    6,
+			p:    0.5,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 0, V1: 2},
+				{ID: 1, V0: 1, V1: 2},
+				{ID: 2, V0: 1, V1: 3},
+				{ID: 3, V0: 2, V1: 3},
+				{ID: 4, V0: 2, V1: 4},
+				{ID: 5, V0: 3, V1: 4},
+				{ID: 6, V0: 2, V1: 5},
+				{ID: 7, V0: 3, V1: 5},
+			},
+		},
+		{
+			name:  "undirected with loops",
+			v:     4,
+			p:     0.5,
+			loops: true,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 0, V1: 2},
+				{ID: 1, V0: 1, V1: 2},
+				{ID: 2, V0: 1, V1: 3},
+				{ID: 3, V0: 2, V1: 3},
+				{ID: 4, V0: 0, V1: 0},
+				{ID: 5, V0: 2, V1: 2},
+			},
+		},
+		{
+			name:     "directed",
+			v:        5,
+			p:        0.6,
+			directed: true,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 0, V1: 2, Directed: true},
+				{ID: 1, V0: 1, V1: 2, Directed: true},
+				{ID: 2, V0: 0, V1: 3, Directed: true},
+				{ID: 3, V0: 1, V1: 3, Directed: true},
+				{ID: 4, V0: 0, V1: 4, Directed: true},
+				{ID: 5, V0: 1, V1: 4, Directed: true},
+				{ID: 6, V0: 3, V1: 4, Directed: true},
+				{ID: 7, V0: 3, V1: 0, Directed: true},
+				{ID: 8, V0: 3, V1: 1, Directed: true},
+				{ID: 9, V0: 4, V1: 0, Directed: true},
+				{ID: 10, V0: 4, V1: 3, Directed: true},
+			},
+		},
+		{
+			name: "1 vertex",
+			v:    1,
+			p:    1,
+			wantVs: []Vertex{
+				{ID: 0},
+			},
+			wantEs: []Edge{},
+		},
+		{
+			name: "edgeless with p=0",
+			v:    5,
+			p:    0,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4},
+			},
+			wantEs: []Edge{},
+		},
+		{
+			name:   "order zero",
+			v:      0,
+			p:      0.5,
+			wantVs: []Vertex{},
+			wantEs: []Edge{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewGnp(tt.v, tt.p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			g.Loops = tt.loops
+			g.Directed = tt.directed
+			g.rand = testRand()
+
+			var gotVs []Vertex
+			for v := range g.Vertices() {
+				gotVs = append(gotVs, v)
+			}
+			if !slices.Equal(gotVs, tt.wantVs) {
+				t.Errorf("unexpected vertices: got: %v, want: %v", gotVs, tt.wantVs)
+			}
+
+			var gotEs []Edge
+			for e := range g.Edges() {
+				gotEs = append(gotEs, e)
+			}
+			if !slices.Equal(gotEs, tt.wantEs) {
+				t.Errorf("unexpected edges: got: %v, want: %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import "io"
+
+// WriteGraph6 writes a random graph to w using the [graph6] format.
+//
+// Unlike [RandGraph.WriteDOT], which streams vertices and edges as
+// they are produced, graph6 is a dense, index-based format: the
+// bit position of an edge depends on the total number of vertices,
+// which is only known once [RandGraph.Vertices] has been fully
+// drained. WriteGraph6 therefore buffers the whole upper triangle of
+// the adjacency matrix as n(n-1)/2 booleans (one byte each) before
+// writing anything, which costs O(n²) memory. Use WriteDOT instead for graphs too large to fit in
+// memory.
+//
+// [graph6]: https://users.cecs.anu.edu.au/~bdm/data/formats.txt
+func (r *RandGraph) WriteGraph6(w io.Writer) {
+	idx, n := indexVertices(r.Vertices())
+
+	bits := make([]bool, n*(n-1)/2)
+	for e := range r.Edges() {
+		i, j := idx[e.V0], idx[e.V1]
+		if i == j {
+			// graph6 has no diagonal; loops are not representable.
+			continue
+		}
+		if i > j {
+			i, j = j, i
+		}
+		bits[j*(j-1)/2+i] = true
+	}
+
+	w.Write(encodeGraph6Size(n))
+	w.Write(packGraph6Bits(bits))
+	w.Write([]byte{'\n'})
+}
+
+// WriteDigraph6 writes a random graph to w using the [digraph6]
+// format, the directed counterpart of graph6. It has the same
+// O(n²) memory tradeoff as [RandGraph.WriteGraph6], since the full
+// n×n adjacency matrix, including the diagonal, must be buffered
+// before anything is written.
+//
+// [digraph6]: https://users.cecs.anu.edu.au/~bdm/data/formats.txt
+func (r *RandGraph) WriteDigraph6(w io.Writer) {
+	idx, n := indexVertices(r.Vertices())
+
+	bits := make([]bool, n*n)
+	for e := range r.Edges() {
+		i, j := idx[e.V0], idx[e.V1]
+		bits[i*n+j] = true
+		if !e.Directed {
+			bits[j*n+i] = true
+		}
+	}
+
+	w.Write([]byte{'&'})
+	w.Write(encodeGraph6Size(n))
+	w.Write(packGraph6Bits(bits))
+	w.Write([]byte{'\n'})
+}
+
+// indexVertices drains ch, assigning each vertex ID a dense index in
+// order of appearance, and returns the mapping along with the total
+// number of vertices seen.
+func indexVertices(ch <-chan Vertex) (idx map[int]int, n int) {
+	idx = make(map[int]int)
+	for v := range ch {
+		idx[v.ID] = n
+		n++
+	}
+	return idx, n
+}
+
+// encodeGraph6Size encodes n using the graph6/digraph6 N(n) scheme:
+// a single byte n+63 for n < 63, 126 followed by three 6-bit bytes
+// for n <= 258047, and 126,126 followed by six 6-bit bytes otherwise.
+// The three-byte form stops at 258047 because a larger n would start
+// with a byte of 126 and read as the six-byte form.
+func encodeGraph6Size(n int) []byte {
+	const base = 63
+
+	switch {
+	case n < 63:
+		return []byte{byte(n + base)}
+	case n <= 258047:
+		b := make([]byte, 4)
+		b[0] = 126
+		for i := range 3 {
+			shift := uint(6 * (2 - i))
+			b[1+i] = byte((n>>shift)&0x3f) + base
+		}
+		return b
+	default:
+		b := make([]byte, 8)
+		b[0] = 126
+		b[1] = 126
+		for i := range 6 {
+			shift := uint(6 * (5 - i))
+			b[2+i] = byte((n>>shift)&0x3f) + base
+		}
+		return b
+	}
+}
+
+// packGraph6Bits packs bits 6 at a time into bytes offset by 63,
+// zero-padding the final group up to a multiple of 6.
+func packGraph6Bits(bits []bool) []byte {
+	const base = 63
+
+	n := len(bits)
+	out := make([]byte, (n+5)/6)
+	for i := range out {
+		var b byte
+		for j := range 6 {
+			b <<= 1
+			k := i*6 + j
+			if k < n && bits[k] {
+				b |= 1
+			}
+		}
+		out[i] = b + base
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRandGraph_WriteGraph6(t *testing.T) {
+	tests := []struct {
+		name     string
+		vertices []Vertex
+		edges    []Edge
+		want     string
+	}{
+		{
+			name: "complete graph on 4 vertices",
+			vertices: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3},
+			},
+			edges: []Edge{
+				{V0: 0, V1: 1}, {V0: 0, V1: 2}, {V0: 0, V1: 3},
+				{V0: 1, V1: 2}, {V0: 1, V1: 3}, {V0: 2, V1: 3},
+			},
+			want: "C~\n",
+		},
+		{
+			name: "edgeless graph on 4 vertices",
+			vertices: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3},
+			},
+			want: "C?\n",
+		},
+		{
+			name: "loops are dropped",
+			vertices: []Vertex{
+				{ID: 0}, {ID: 1},
+			},
+			edges: []Edge{
+				{V0: 0, V1: 0}, {V0: 0, V1: 1},
+			},
+			want: "A_\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(newTestSource(tt.vertices, tt.edges))
+			buf := &bytes.Buffer{}
+			r.WriteGraph6(buf)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("unexpected output: got: %q, want: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRandGraph_WriteDigraph6(t *testing.T) {
+	tests := []struct {
+		name     string
+		vertices []Vertex
+		edges    []Edge
+		want     string
+	}{
+		{
+			name: "directed 3-cycle",
+			vertices: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2},
+			},
+			edges: []Edge{
+				{V0: 0, V1: 1, Directed: true},
+				{V0: 1, V1: 2, Directed: true},
+				{V0: 2, V1: 0, Directed: true},
+			},
+			want: "&BP_\n",
+		},
+		{
+			name: "undirected edges are symmetric",
+			vertices: []Vertex{
+				{ID: 0}, {ID: 1},
+			},
+			edges: []Edge{
+				{V0: 0, V1: 1},
+			},
+			want: "&AW\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(newTestSource(tt.vertices, tt.edges))
+			buf := &bytes.Buffer{}
+			r.WriteDigraph6(buf)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("unexpected output: got: %q, want: %q", got, tt.want)
+			}
+		})
+	}
+}
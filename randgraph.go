@@ -128,6 +128,14 @@ type Binomial struct {
 // binomial distribution B(n, p), where n is the number of trials and
 // p the success probability for each trial.
 func NewBinomial(v, n int, p float64) (*Binomial, error) {
+	return NewBinomialWithRand(v, n, p, Seed(rand.Uint64(), rand.Uint64()))
+}
+
+// NewBinomialWithRand is like [NewBinomial], but it uses r as the
+// source of randomness instead of a randomly seeded one. This allows
+// reproducible runs and sharing a single [rand.Rand] across a
+// pipeline of sources.
+func NewBinomialWithRand(v, n int, p float64, r *rand.Rand) (*Binomial, error) {
 	if v < 0 {
 		return nil, errors.New("invalid number of vertices")
 	}
@@ -142,11 +150,18 @@ func NewBinomial(v, n int, p float64) (*Binomial, error) {
 		V:    v,
 		N:    n,
 		P:    p,
-		rand: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+		rand: r,
 	}
 	return b, nil
 }
 
+// Seed returns a new [rand.Rand] deterministically seeded from seed1
+// and seed2, using [rand.NewPCG]. It is a convenience helper for the
+// *WithRand constructors exposed by this package.
+func Seed(seed1, seed2 uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed1, seed2))
+}
+
 func (b *Binomial) Vertices() <-chan Vertex {
 	ch := make(chan Vertex)
 	go func() {
@@ -170,6 +170,42 @@ func TestNewBinomial(t *testing.T) {
 	}
 }
 
+func TestNewBinomialWithRand(t *testing.T) {
+	b, err := NewBinomialWithRand(2, 1, 1, testRand())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := NewBinomial(2, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want.rand = testRand()
+
+	var gotEs, wantEs []Edge
+	for e := range b.Edges() {
+		gotEs = append(gotEs, e)
+	}
+	for e := range want.Edges() {
+		wantEs = append(wantEs, e)
+	}
+	if !slices.Equal(gotEs, wantEs) {
+		t.Errorf("unexpected edges: got: %v, want: %v", gotEs, wantEs)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	r1 := Seed(1, 2)
+	r2 := Seed(1, 2)
+
+	for i := 0; i < 10; i++ {
+		got, want := r1.Uint64(), r2.Uint64()
+		if got != want {
+			t.Errorf("Seed is not deterministic: got: %v, want: %v", got, want)
+		}
+	}
+}
+
 func TestBinomial(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,143 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import (
+	"errors"
+	"math/rand/v2"
+)
+
+// WattsStrogatz implements the [Source] interface. It generates
+// small-world random graphs: start from a ring where every vertex is
+// connected to its K nearest neighbours, then rewire each edge to a
+// random vertex with probability Beta. Low Beta keeps the high
+// clustering of the ring, while the few rewired edges drastically
+// shorten the average path length, giving the "clustered but short
+// path" regime that complements the uncorrelated [Binomial]/[Gnp] and
+// scale-free [BarabasiAlbert] sources.
+type WattsStrogatz struct {
+	// V is the number of vertices.
+	V int
+
+	// K is the number of ring neighbours each vertex connects to. It
+	// must be even and less than V.
+	K int
+
+	// Beta is the rewiring probability.
+	Beta float64
+
+	// Directed defines whether the generated graphs are directed.
+	Directed bool
+
+	// VertexLabel specifies an optional function that returns the
+	// label of a vertex identified by id.
+	VertexLabel func(id int) any
+
+	// EdgeLabel specifies an optional function that returns the
+	// label of an edge identified by id that connects v0 and v1.
+	EdgeLabel func(id, v0, v1 int) any
+
+	rand *rand.Rand
+}
+
+// NewWattsStrogatz returns a new [WattsStrogatz] source that
+// generates graphs with v vertices arranged in a ring, each connected
+// to its k nearest neighbours and rewired with probability beta.
+func NewWattsStrogatz(v, k int, beta float64) (*WattsStrogatz, error) {
+	return NewWattsStrogatzWithRand(v, k, beta, Seed(rand.Uint64(), rand.Uint64()))
+}
+
+// NewWattsStrogatzWithRand is like [NewWattsStrogatz], but it uses r
+// as the source of randomness instead of a randomly seeded one. This
+// allows reproducible runs and sharing a single [rand.Rand] across a
+// pipeline of sources.
+func NewWattsStrogatzWithRand(v, k int, beta float64, r *rand.Rand) (*WattsStrogatz, error) {
+	if v < 0 {
+		return nil, errors.New("invalid number of vertices")
+	}
+	if k < 0 || k%2 != 0 {
+		return nil, errors.New("invalid number of neighbours")
+	}
+	if k >= v {
+		return nil, errors.New("k must be less than v")
+	}
+	if beta < 0 || beta > 1 {
+		return nil, errors.New("invalid rewiring probability")
+	}
+
+	ws := &WattsStrogatz{
+		V:    v,
+		K:    k,
+		Beta: beta,
+		rand: r,
+	}
+	return ws, nil
+}
+
+func (ws *WattsStrogatz) Vertices() <-chan Vertex {
+	ch := make(chan Vertex)
+	go func() {
+		for i := range ws.V {
+			var label any
+			if ws.VertexLabel != nil {
+				label = ws.VertexLabel(i)
+			}
+			ch <- Vertex{ID: i, Label: label}
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+func (ws *WattsStrogatz) Edges() <-chan Edge {
+	ch := make(chan Edge)
+	go func() {
+		id := 0
+		emit := func(v0, v1 int) {
+			var label any
+			if ws.EdgeLabel != nil {
+				label = ws.EdgeLabel(id, v0, v1)
+			}
+			ch <- Edge{
+				ID:       id,
+				V0:       v0,
+				V1:       v1,
+				Directed: ws.Directed,
+				Label:    label,
+			}
+			id++
+		}
+
+		for i := range ws.V {
+			fanout := make(map[int]struct{}, ws.K/2)
+			for j := 1; j <= ws.K/2; j++ {
+				far := (i + j) % ws.V
+				_, dup := fanout[far]
+				if dup || ws.rand.Float64() < ws.Beta {
+					// A ring edge that coincides with a vertex
+					// already in the fan-out (because an earlier j
+					// rewired onto it) can't be kept as-is without
+					// creating a duplicate edge, so it is rewired too.
+					for {
+						candidate := ws.rand.IntN(ws.V)
+						if candidate == i {
+							continue
+						}
+						if _, found := fanout[candidate]; found {
+							continue
+						}
+						far = candidate
+						break
+					}
+				}
+				fanout[far] = struct{}{}
+				emit(i, far)
+			}
+		}
+
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2025 Roi Martin
+// Use of this source code is governed by the MIT license that can be
+// found in the LICENSE file.
+
+package randgraph
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestWattsStrogatz_NoDuplicateEdges is a regression test for a bug
+// where a vertex whose ring edge happened to coincide with an earlier
+// rewired neighbour was emitted unchanged, producing a duplicate edge.
+// K/2 >= 3 combined with a high Beta makes such collisions likely.
+// Edges are compared as ordered (V0, V1) pairs, so an edge emitted
+// from one endpoint and again, reversed, from the other is not
+// reported.
+func TestWattsStrogatz_NoDuplicateEdges(t *testing.T) {
+	ws, err := NewWattsStrogatz(10, 6, 0.9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws.rand = testRand()
+
+	seen := make(map[[2]int]bool)
+	for e := range ws.Edges() {
+		pair := [2]int{e.V0, e.V1}
+		if seen[pair] {
+			t.Errorf("duplicate edge: %v", e)
+		}
+		seen[pair] = true
+	}
+}
+
+func TestNewWattsStrogatz(t *testing.T) {
+	tests := []struct {
+		name       string
+		v          int
+		k          int
+		beta       float64
+		wantNilErr bool
+	}{
+		{
+			name:       "zero",
+			wantNilErr: false,
+		},
+		{
+			name:       "valid",
+			v:          8,
+			k:          4,
+			beta:       0.3,
+			wantNilErr: true,
+		},
+		{
+			name:       "v < 0",
+			v:          -1,
+			wantNilErr: false,
+		},
+		{
+			name:       "k < 0",
+			v:          8,
+			k:          -2,
+			wantNilErr: false,
+		},
+		{
+			name:       "k odd",
+			v:          8,
+			k:          3,
+			wantNilErr: false,
+		},
+		{
+			name:       "k >= v",
+			v:          4,
+			k:          4,
+			wantNilErr: false,
+		},
+		{
+			name:       "beta < 0",
+			v:          8,
+			k:          4,
+			beta:       -0.1,
+			wantNilErr: false,
+		},
+		{
+			name:       "beta > 1",
+			v:          8,
+			k:          4,
+			beta:       1.1,
+			wantNilErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws, err := NewWattsStrogatz(tt.v, tt.k, tt.beta)
+			if (err == nil) != tt.wantNilErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if (ws == nil) != !tt.wantNilErr {
+				t.Errorf("unexpected value: %v", ws)
+			}
+		})
+	}
+}
+
+func TestNewWattsStrogatzWithRand(t *testing.T) {
+	ws, err := NewWattsStrogatzWithRand(8, 4, 0.3, testRand())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := NewWattsStrogatz(8, 4, 0.3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want.rand = testRand()
+
+	var gotEs, wantEs []Edge
+	for e := range ws.Edges() {
+		gotEs = append(gotEs, e)
+	}
+	for e := range want.Edges() {
+		wantEs = append(wantEs, e)
+	}
+	if !slices.Equal(gotEs, wantEs) {
+		t.Errorf("unexpected edges: got: %v, want: %v", gotEs, wantEs)
+	}
+}
+
+func TestWattsStrogatz(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        int
+		k        int
+		beta     float64
+		directed bool
+		wantVs   []Vertex
+		wantEs   []Edge
+	}{
+		{
+			name: "rewired",
+			v:    8,
+			k:    4,
+			beta: 0.3,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}, {ID: 6}, {ID: 7},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 0, V1: 1},
+				{ID: 1, V0: 0, V1: 2},
+				{ID: 2, V0: 1, V1: 2},
+				{ID: 3, V0: 1, V1: 3},
+				{ID: 4, V0: 2, V1: 3},
+				{ID: 5, V0: 2, V1: 4},
+				{ID: 6, V0: 3, V1: 4},
+				{ID: 7, V0: 3, V1: 5},
+				{ID: 8, V0: 4, V1: 5},
+				{ID: 9, V0: 4, V1: 7},
+				{ID: 10, V0: 5, V1: 6},
+				{ID: 11, V0: 5, V1: 3},
+				{ID: 12, V0: 6, V1: 7},
+				{ID: 13, V0: 6, V1: 0},
+				{ID: 14, V0: 7, V1: 0},
+				{ID: 15, V0: 7, V1: 1},
+			},
+		},
+		{
+			name: "ring only",
+			v:    8,
+			k:    4,
+			beta: 0,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}, {ID: 6}, {ID: 7},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 0, V1: 1},
+				{ID: 1, V0: 0, V1: 2},
+				{ID: 2, V0: 1, V1: 2},
+				{ID: 3, V0: 1, V1: 3},
+				{ID: 4, V0: 2, V1: 3},
+				{ID: 5, V0: 2, V1: 4},
+				{ID: 6, V0: 3, V1: 4},
+				{ID: 7, V0: 3, V1: 5},
+				{ID: 8, V0: 4, V1: 5},
+				{ID: 9, V0: 4, V1: 6},
+				{ID: 10, V0: 5, V1: 6},
+				{ID: 11, V0: 5, V1: 7},
+				{ID: 12, V0: 6, V1: 7},
+				{ID: 13, V0: 6, V1: 0},
+				{ID: 14, V0: 7, V1: 0},
+				{ID: 15, V0: 7, V1: 1},
+			},
+		},
+		{
+			name:     "directed",
+			v:        8,
+			k:        4,
+			beta:     0.3,
+			directed: true,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}, {ID: 6}, {ID: 7},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 0, V1: 1, Directed: true},
+				{ID: 1, V0: 0, V1: 2, Directed: true},
+				{ID: 2, V0: 1, V1: 2, Directed: true},
+				{ID: 3, V0: 1, V1: 3, Directed: true},
+				{ID: 4, V0: 2, V1: 3, Directed: true},
+				{ID: 5, V0: 2, V1: 4, Directed: true},
+				{ID: 6, V0: 3, V1: 4, Directed: true},
+				{ID: 7, V0: 3, V1: 5, Directed: true},
+				{ID: 8, V0: 4, V1: 5, Directed: true},
+				{ID: 9, V0: 4, V1: 7, Directed: true},
+				{ID: 10, V0: 5, V1: 6, Directed: true},
+				{ID: 11, V0: 5, V1: 3, Directed: true},
+				{ID: 12, V0: 6, V1: 7, Directed: true},
+				{ID: 13, V0: 6, V1: 0, Directed: true},
+				{ID: 14, V0: 7, V1: 0, Directed: true},
+				{ID: 15, V0: 7, V1: 1, Directed: true},
+			},
+		},
+		{
+			name: "small ring",
+			v:    3,
+			k:    2,
+			beta: 0.5,
+			wantVs: []Vertex{
+				{ID: 0}, {ID: 1}, {ID: 2},
+			},
+			wantEs: []Edge{
+				{ID: 0, V0: 0, V1: 1},
+				{ID: 1, V0: 1, V1: 2},
+				{ID: 2, V0: 2, V1: 0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws, err := NewWattsStrogatz(tt.v, tt.k, tt.beta)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ws.Directed = tt.directed
+			ws.rand = testRand()
+
+			var gotVs []Vertex
+			for v := range ws.Vertices() {
+				gotVs = append(gotVs, v)
+			}
+			if !slices.Equal(gotVs, tt.wantVs) {
+				t.Errorf("unexpected vertices: got: %v, want: %v", gotVs, tt.wantVs)
+			}
+
+			var gotEs []Edge
+			for e := range ws.Edges() {
+				gotEs = append(gotEs, e)
+			}
+			if !slices.Equal(gotEs, tt.wantEs) {
+				t.Errorf("unexpected edges: got: %v, want: %v", gotEs, tt.wantEs)
+			}
+		})
+	}
+}